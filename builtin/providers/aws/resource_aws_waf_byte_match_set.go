@@ -3,6 +3,7 @@ package aws
 import (
 	"log"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -17,6 +18,10 @@ func resourceAwsWafByteMatchSet() *schema.Resource {
 		Read:   resourceAwsWafByteMatchSetRead,
 		Update: resourceAwsWafByteMatchSetUpdate,
 		Delete: resourceAwsWafByteMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateWafByteMatchSetFieldToMatch,
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -38,25 +43,31 @@ func resourceAwsWafByteMatchSet() *schema.Resource {
 									"data": {
 										Type:     schema.TypeString,
 										Optional: true,
+										StateFunc: func(v interface{}) string {
+											return strings.ToLower(v.(string))
+										},
 									},
 									"type": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateWafFieldToMatchType,
 									},
 								},
 							},
 						},
 						"positional_constraint": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateWafPositionalConstraint,
 						},
 						"target_string": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
 						},
 						"text_transformation": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateWafTextTransformation,
 						},
 					},
 				},
@@ -90,7 +101,7 @@ func resourceAwsWafByteMatchSetCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsWafByteMatchSetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).wafconn
-	log.Printf("[INFO] Reading ByteMatchSet: %s", d.Get("name").(string))
+	log.Printf("[INFO] Reading ByteMatchSet: %s", d.Id())
 	params := &waf.GetByteMatchSetInput{
 		ByteMatchSetId: aws.String(d.Id()),
 	}
@@ -175,76 +186,7 @@ func updateByteMatchSetResource(id string, oldT, newT []interface{}, conn *waf.W
 	return nil
 }
 
-func expandFieldToMatch(d []interface{}) *waf.FieldToMatch {
-	if len(d) == 0 {
-		return nil
-	}
-
-	m := d[0].(map[string]interface{})
-	return &waf.FieldToMatch{
-		Type: aws.String(m["type"].(string)),
-		Data: aws.String(m["data"].(string)),
-	}
-}
-
-func flattenWafByteMatchTuples(in []*waf.ByteMatchTuple) []interface{} {
-	out := make([]interface{}, len(in), len(in))
-	for i, t := range in {
-		m := make(map[string]interface{}, 0)
-		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
-		m["positional_constraint"] = *t.PositionalConstraint
-		m["target_string"] = string(t.TargetString)
-		m["text_transformation"] = *t.TextTransformation
-
-		out[i] = m
-	}
-	return out
-}
-
-func flattenFieldToMatch(fm *waf.FieldToMatch) []interface{} {
-	m := make(map[string]interface{})
-	if fm.Data != nil {
-		m["data"] = *fm.Data
-	}
-	m["type"] = *fm.Type
-	return []interface{}{m}
-}
-
-func diffWafByteMatchSetTuples(oldT, newT []interface{}) []*waf.ByteMatchSetUpdate {
-	updates := make([]*waf.ByteMatchSetUpdate, 0)
-
-	for _, ot := range oldT {
-		tuple := ot.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newT, tuple); contains {
-			newT = append(newT[:idx], newT[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.ByteMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			ByteMatchTuple: &waf.ByteMatchTuple{
-				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
-				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
-				TargetString:         []byte(tuple["target_string"].(string)),
-				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, nt := range newT {
-		tuple := nt.(map[string]interface{})
-
-		updates = append(updates, &waf.ByteMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			ByteMatchTuple: &waf.ByteMatchTuple{
-				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
-				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
-				TargetString:         []byte(tuple["target_string"].(string)),
-				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	return updates
-}
+// Shared helpers (expandFieldToMatch, flattenFieldToMatch,
+// flattenWafByteMatchTuples, diffWafByteMatchSetTuples) now live in
+// structures_waf.go so resource_aws_wafregional_byte_match_set.go can
+// reuse them.
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalIPSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalIPSetCreate,
+		Read:   resourceAwsWafRegionalIPSetRead,
+		Update: resourceAwsWafRegionalIPSetUpdate,
+		Delete: resourceAwsWafRegionalIPSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_set_descriptors": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalIPSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	log.Printf("[INFO] Creating WAF Regional IPSet: %s", d.Get("name").(string))
+
+	wr := newWafRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateIPSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+		return conn.CreateIPSet(params)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error creating WAF Regional IPSet: {{err}}", err)
+	}
+	resp := out.(*waf.CreateIPSetOutput)
+
+	d.SetId(*resp.IPSet.IPSetId)
+
+	return resourceAwsWafRegionalIPSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalIPSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	log.Printf("[INFO] Reading WAF Regional IPSet: %s", d.Get("name").(string))
+	params := &waf.GetIPSetInput{
+		IPSetId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetIPSet(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "WAFNonexistentItemException" {
+			log.Printf("[WARN] WAF Regional IPSet (%s) not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", resp.IPSet.Name)
+	d.Set("ip_set_descriptors", flattenWafIPSetDescriptors(resp.IPSet.IPSetDescriptors))
+
+	return nil
+}
+
+func resourceAwsWafRegionalIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("ip_set_descriptors") {
+		o, n := d.GetChange("ip_set_descriptors")
+		oldD, newD := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalIPSetResource(d.Id(), oldD, newD, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error updating WAF Regional IPSet: {{err}}", err)
+		}
+	}
+
+	return resourceAwsWafRegionalIPSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalIPSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	oldD := d.Get("ip_set_descriptors").(*schema.Set).List()
+	if len(oldD) > 0 {
+		noDescriptors := []interface{}{}
+		err := updateWafRegionalIPSetResource(d.Id(), oldD, noDescriptors, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error deleting WAF Regional IPSet: {{err}}", err)
+		}
+	}
+
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteIPSetInput{
+			ChangeToken: token,
+			IPSetId:     aws.String(d.Id()),
+		}
+		log.Printf("[INFO] Deleting WAF Regional IPSet: %s", req)
+		return conn.DeleteIPSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error deleting WAF Regional IPSet: {{err}}", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalIPSetResource(id string, oldD, newD []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateIPSetInput{
+			ChangeToken: token,
+			IPSetId:     aws.String(id),
+			Updates:     diffWafIPSetDescriptors(oldD, newD),
+		}
+		log.Printf("[INFO] Updating WAF Regional IPSet: %s", req)
+
+		return conn.UpdateIPSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error updating WAF Regional IPSet: {{err}}", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalSizeConstraintSet() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsWafRegionalSizeConstraintSetCreate,
+		Read:          resourceAwsWafRegionalSizeConstraintSetRead,
+		Update:        resourceAwsWafRegionalSizeConstraintSetUpdate,
+		Delete:        resourceAwsWafRegionalSizeConstraintSetDelete,
+		CustomizeDiff: validateWafSizeConstraintSetFieldToMatch,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size_constraints": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_to_match": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data": {
+										Type:     schema.TypeString,
+										Optional: true,
+										StateFunc: func(v interface{}) string {
+											return strings.ToLower(v.(string))
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateWafFieldToMatchType,
+									},
+								},
+							},
+						},
+						"comparison_operator": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"text_transformation": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalSizeConstraintSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	log.Printf("[INFO] Creating WAF Regional SizeConstraintSet: %s", d.Get("name").(string))
+
+	wr := newWafRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateSizeConstraintSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+		return conn.CreateSizeConstraintSet(params)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error creating WAF Regional SizeConstraintSet: {{err}}", err)
+	}
+	resp := out.(*waf.CreateSizeConstraintSetOutput)
+
+	d.SetId(*resp.SizeConstraintSet.SizeConstraintSetId)
+
+	return resourceAwsWafRegionalSizeConstraintSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalSizeConstraintSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	log.Printf("[INFO] Reading WAF Regional SizeConstraintSet: %s", d.Get("name").(string))
+	params := &waf.GetSizeConstraintSetInput{
+		SizeConstraintSetId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetSizeConstraintSet(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "WAFNonexistentItemException" {
+			log.Printf("[WARN] WAF Regional SizeConstraintSet (%s) not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", resp.SizeConstraintSet.Name)
+	d.Set("size_constraints", flattenWafSizeConstraints(resp.SizeConstraintSet.SizeConstraints))
+
+	return nil
+}
+
+func resourceAwsWafRegionalSizeConstraintSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("size_constraints") {
+		o, n := d.GetChange("size_constraints")
+		oldS, newS := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalSizeConstraintSetResource(d.Id(), oldS, newS, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error updating WAF Regional SizeConstraintSet: {{err}}", err)
+		}
+	}
+
+	return resourceAwsWafRegionalSizeConstraintSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalSizeConstraintSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	oldS := d.Get("size_constraints").(*schema.Set).List()
+	if len(oldS) > 0 {
+		noConstraints := []interface{}{}
+		err := updateWafRegionalSizeConstraintSetResource(d.Id(), oldS, noConstraints, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error deleting WAF Regional SizeConstraintSet: {{err}}", err)
+		}
+	}
+
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteSizeConstraintSetInput{
+			ChangeToken:         token,
+			SizeConstraintSetId: aws.String(d.Id()),
+		}
+		log.Printf("[INFO] Deleting WAF Regional SizeConstraintSet: %s", req)
+		return conn.DeleteSizeConstraintSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error deleting WAF Regional SizeConstraintSet: {{err}}", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalSizeConstraintSetResource(id string, oldS, newS []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateSizeConstraintSetInput{
+			ChangeToken:         token,
+			SizeConstraintSetId: aws.String(id),
+			Updates:             diffWafSizeConstraints(oldS, newS),
+		}
+		log.Printf("[INFO] Updating WAF Regional SizeConstraintSet: %s", req)
+
+		return conn.UpdateSizeConstraintSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error updating WAF Regional SizeConstraintSet: {{err}}", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalSqlInjectionMatchSet() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsWafRegionalSqlInjectionMatchSetCreate,
+		Read:          resourceAwsWafRegionalSqlInjectionMatchSetRead,
+		Update:        resourceAwsWafRegionalSqlInjectionMatchSetUpdate,
+		Delete:        resourceAwsWafRegionalSqlInjectionMatchSetDelete,
+		CustomizeDiff: validateWafSqlInjectionMatchSetFieldToMatch,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sql_injection_match_tuples": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_to_match": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data": {
+										Type:     schema.TypeString,
+										Optional: true,
+										StateFunc: func(v interface{}) string {
+											return strings.ToLower(v.(string))
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateWafFieldToMatchType,
+									},
+								},
+							},
+						},
+						"text_transformation": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalSqlInjectionMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	log.Printf("[INFO] Creating WAF Regional SqlInjectionMatchSet: %s", d.Get("name").(string))
+
+	wr := newWafRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateSqlInjectionMatchSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+		return conn.CreateSqlInjectionMatchSet(params)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error creating WAF Regional SqlInjectionMatchSet: {{err}}", err)
+	}
+	resp := out.(*waf.CreateSqlInjectionMatchSetOutput)
+
+	d.SetId(*resp.SqlInjectionMatchSet.SqlInjectionMatchSetId)
+
+	return resourceAwsWafRegionalSqlInjectionMatchSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalSqlInjectionMatchSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	log.Printf("[INFO] Reading WAF Regional SqlInjectionMatchSet: %s", d.Get("name").(string))
+	params := &waf.GetSqlInjectionMatchSetInput{
+		SqlInjectionMatchSetId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetSqlInjectionMatchSet(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "WAFNonexistentItemException" {
+			log.Printf("[WARN] WAF Regional SqlInjectionMatchSet (%s) not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", resp.SqlInjectionMatchSet.Name)
+	d.Set("sql_injection_match_tuples", flattenWafSqlInjectionMatchTuples(resp.SqlInjectionMatchSet.SqlInjectionMatchTuples))
+
+	return nil
+}
+
+func resourceAwsWafRegionalSqlInjectionMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("sql_injection_match_tuples") {
+		o, n := d.GetChange("sql_injection_match_tuples")
+		oldT, newT := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalSqlInjectionMatchSetResource(d.Id(), oldT, newT, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error updating WAF Regional SqlInjectionMatchSet: {{err}}", err)
+		}
+	}
+
+	return resourceAwsWafRegionalSqlInjectionMatchSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalSqlInjectionMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	oldT := d.Get("sql_injection_match_tuples").(*schema.Set).List()
+	if len(oldT) > 0 {
+		noTuples := []interface{}{}
+		err := updateWafRegionalSqlInjectionMatchSetResource(d.Id(), oldT, noTuples, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error deleting WAF Regional SqlInjectionMatchSet: {{err}}", err)
+		}
+	}
+
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteSqlInjectionMatchSetInput{
+			ChangeToken:            token,
+			SqlInjectionMatchSetId: aws.String(d.Id()),
+		}
+		log.Printf("[INFO] Deleting WAF Regional SqlInjectionMatchSet: %s", req)
+		return conn.DeleteSqlInjectionMatchSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error deleting WAF Regional SqlInjectionMatchSet: {{err}}", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalSqlInjectionMatchSetResource(id string, oldT, newT []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateSqlInjectionMatchSetInput{
+			ChangeToken:            token,
+			SqlInjectionMatchSetId: aws.String(id),
+			Updates:                diffWafSqlInjectionMatchTuples(oldT, newT),
+		}
+		log.Printf("[INFO] Updating WAF Regional SqlInjectionMatchSet: %s", req)
+
+		return conn.UpdateSqlInjectionMatchSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error updating WAF Regional SqlInjectionMatchSet: {{err}}", err)
+	}
+
+	return nil
+}
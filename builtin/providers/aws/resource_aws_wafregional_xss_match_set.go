@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalXssMatchSet() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsWafRegionalXssMatchSetCreate,
+		Read:          resourceAwsWafRegionalXssMatchSetRead,
+		Update:        resourceAwsWafRegionalXssMatchSetUpdate,
+		Delete:        resourceAwsWafRegionalXssMatchSetDelete,
+		CustomizeDiff: validateWafXssMatchSetFieldToMatch,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"xss_match_tuples": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_to_match": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data": {
+										Type:     schema.TypeString,
+										Optional: true,
+										StateFunc: func(v interface{}) string {
+											return strings.ToLower(v.(string))
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateWafFieldToMatchType,
+									},
+								},
+							},
+						},
+						"text_transformation": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalXssMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	log.Printf("[INFO] Creating WAF Regional XssMatchSet: %s", d.Get("name").(string))
+
+	wr := newWafRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateXssMatchSetInput{
+			ChangeToken: token,
+			Name:        aws.String(d.Get("name").(string)),
+		}
+		return conn.CreateXssMatchSet(params)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error creating WAF Regional XssMatchSet: {{err}}", err)
+	}
+	resp := out.(*waf.CreateXssMatchSetOutput)
+
+	d.SetId(*resp.XssMatchSet.XssMatchSetId)
+
+	return resourceAwsWafRegionalXssMatchSetUpdate(d, meta)
+}
+
+func resourceAwsWafRegionalXssMatchSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	log.Printf("[INFO] Reading WAF Regional XssMatchSet: %s", d.Get("name").(string))
+	params := &waf.GetXssMatchSetInput{
+		XssMatchSetId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetXssMatchSet(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "WAFNonexistentItemException" {
+			log.Printf("[WARN] WAF Regional XssMatchSet (%s) not found, error code (404)", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("name", resp.XssMatchSet.Name)
+	d.Set("xss_match_tuples", flattenWafXssMatchTuples(resp.XssMatchSet.XssMatchTuples))
+
+	return nil
+}
+
+func resourceAwsWafRegionalXssMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("xss_match_tuples") {
+		o, n := d.GetChange("xss_match_tuples")
+		oldT, newT := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalXssMatchSetResource(d.Id(), oldT, newT, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error updating WAF Regional XssMatchSet: {{err}}", err)
+		}
+	}
+
+	return resourceAwsWafRegionalXssMatchSetRead(d, meta)
+}
+
+func resourceAwsWafRegionalXssMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	oldT := d.Get("xss_match_tuples").(*schema.Set).List()
+	if len(oldT) > 0 {
+		noTuples := []interface{}{}
+		err := updateWafRegionalXssMatchSetResource(d.Id(), oldT, noTuples, conn, region)
+		if err != nil {
+			return errwrap.Wrapf("[ERROR] Error deleting WAF Regional XssMatchSet: {{err}}", err)
+		}
+	}
+
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteXssMatchSetInput{
+			ChangeToken:   token,
+			XssMatchSetId: aws.String(d.Id()),
+		}
+		log.Printf("[INFO] Deleting WAF Regional XssMatchSet: %s", req)
+		return conn.DeleteXssMatchSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error deleting WAF Regional XssMatchSet: {{err}}", err)
+	}
+
+	return nil
+}
+
+func updateWafRegionalXssMatchSetResource(id string, oldT, newT []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateXssMatchSetInput{
+			ChangeToken:   token,
+			XssMatchSetId: aws.String(id),
+			Updates:       diffWafXssMatchTuples(oldT, newT),
+		}
+		log.Printf("[INFO] Updating WAF Regional XssMatchSet: %s", req)
+
+		return conn.UpdateXssMatchSet(req)
+	})
+	if err != nil {
+		return errwrap.Wrapf("[ERROR] Error updating WAF Regional XssMatchSet: {{err}}", err)
+	}
+
+	return nil
+}
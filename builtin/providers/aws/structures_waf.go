@@ -0,0 +1,394 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared helpers for building/flattening the tuple-style WAF resources
+// (byte match sets, IP sets, SQL injection match sets, size constraint
+// sets and XSS match sets), all backed by the same `waf` SDK types. Only
+// byte match sets have both a global (CloudFront) `aws_waf_byte_match_set`
+// and a regional (ALB) `aws_wafregional_byte_match_set`; IP sets, SQL
+// injection match sets, size constraint sets and XSS match sets exist only
+// as `aws_wafregional_*` resources.
+
+func expandFieldToMatch(d []interface{}) *waf.FieldToMatch {
+	if len(d) == 0 {
+		return nil
+	}
+
+	m := d[0].(map[string]interface{})
+	return &waf.FieldToMatch{
+		Type: aws.String(m["type"].(string)),
+		// AWS always stores field_to_match.data lowercased. The schema's
+		// StateFunc keeps config and state from perpetually diffing; this
+		// lowering just guarantees the API call itself is well-formed even
+		// before that value has round-tripped through state.
+		Data: aws.String(strings.ToLower(m["data"].(string))),
+	}
+}
+
+// validateWafFieldToMatchData enforces the AWS API contract that
+// field_to_match.data is required when type is HEADER or SINGLE_QUERY_ARG,
+// and must be omitted for every other type.
+func validateWafFieldToMatchData(fieldToMatch []interface{}) error {
+	if len(fieldToMatch) == 0 {
+		return nil
+	}
+
+	m := fieldToMatch[0].(map[string]interface{})
+	t := m["type"].(string)
+	data, _ := m["data"].(string)
+
+	switch t {
+	case waf.MatchFieldTypeHeader, waf.MatchFieldTypeSingleQueryArg:
+		if data == "" {
+			return fmt.Errorf("field_to_match.data is required when field_to_match.type is %q", t)
+		}
+	default:
+		if data != "" {
+			return fmt.Errorf("field_to_match.data must not be set when field_to_match.type is %q", t)
+		}
+	}
+
+	return nil
+}
+
+// validateWafFieldToMatchInTuples builds a CustomizeDiff for a WAF match
+// set resource whose tuples (stored under tuplesAttr) each embed a single
+// field_to_match (stored under fieldToMatchKey), since ValidateFunc alone
+// cannot see the sibling "type" field from within "data"'s validation.
+func validateWafFieldToMatchInTuples(tuplesAttr, fieldToMatchKey string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		for _, t := range d.Get(tuplesAttr).(*schema.Set).List() {
+			tuple := t.(map[string]interface{})
+			if err := validateWafFieldToMatchData(tuple[fieldToMatchKey].(*schema.Set).List()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// CustomizeDiff funcs for the WAF match set resources that embed
+// field_to_match, one per resource family (global and regional byte match
+// sets share the same tuple/attribute shape).
+var validateWafByteMatchSetFieldToMatch = validateWafFieldToMatchInTuples("byte_match_tuples", "field_to_match")
+var validateWafSqlInjectionMatchSetFieldToMatch = validateWafFieldToMatchInTuples("sql_injection_match_tuples", "field_to_match")
+var validateWafSizeConstraintSetFieldToMatch = validateWafFieldToMatchInTuples("size_constraints", "field_to_match")
+var validateWafXssMatchSetFieldToMatch = validateWafFieldToMatchInTuples("xss_match_tuples", "field_to_match")
+
+func validateWafPositionalConstraint(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validValues := []string{
+		waf.PositionalConstraintContains,
+		waf.PositionalConstraintContainsWord,
+		waf.PositionalConstraintEndsWith,
+		waf.PositionalConstraintExactly,
+		waf.PositionalConstraintStartsWith,
+	}
+	for _, s := range validValues {
+		if value == s {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q must be one of %v, got %q", k, validValues, value))
+	return
+}
+
+func validateWafTextTransformation(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validValues := []string{
+		waf.TextTransformationCmdLine,
+		waf.TextTransformationCompressWhiteSpace,
+		waf.TextTransformationHtmlEntityDecode,
+		waf.TextTransformationLowercase,
+		waf.TextTransformationNone,
+		waf.TextTransformationUrlDecode,
+	}
+	for _, s := range validValues {
+		if value == s {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q must be one of %v, got %q", k, validValues, value))
+	return
+}
+
+func validateWafFieldToMatchType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validValues := []string{
+		waf.MatchFieldTypeUri,
+		waf.MatchFieldTypeQueryString,
+		waf.MatchFieldTypeHeader,
+		waf.MatchFieldTypeMethod,
+		waf.MatchFieldTypeBody,
+		waf.MatchFieldTypeSingleQueryArg,
+		waf.MatchFieldTypeAllQueryArgs,
+	}
+	for _, s := range validValues {
+		if value == s {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q must be one of %v, got %q", k, validValues, value))
+	return
+}
+
+func flattenFieldToMatch(fm *waf.FieldToMatch) []interface{} {
+	m := make(map[string]interface{})
+	if fm.Data != nil {
+		m["data"] = *fm.Data
+	}
+	m["type"] = *fm.Type
+	return []interface{}{m}
+}
+
+func flattenWafByteMatchTuples(in []*waf.ByteMatchTuple) []interface{} {
+	out := make([]interface{}, len(in), len(in))
+	for i, t := range in {
+		m := make(map[string]interface{}, 0)
+		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
+		m["positional_constraint"] = *t.PositionalConstraint
+		m["target_string"] = string(t.TargetString)
+		m["text_transformation"] = *t.TextTransformation
+
+		out[i] = m
+	}
+	return out
+}
+
+func diffWafByteMatchSetTuples(oldT, newT []interface{}) []*waf.ByteMatchSetUpdate {
+	updates := make([]*waf.ByteMatchSetUpdate, 0)
+
+	for _, ot := range oldT {
+		tuple := ot.(map[string]interface{})
+
+		if idx, contains := sliceContainsMap(newT, tuple); contains {
+			newT = append(newT[:idx], newT[idx+1:]...)
+			continue
+		}
+
+		updates = append(updates, &waf.ByteMatchSetUpdate{
+			Action: aws.String(waf.ChangeActionDelete),
+			ByteMatchTuple: &waf.ByteMatchTuple{
+				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
+				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
+				TargetString:         []byte(tuple["target_string"].(string)),
+				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
+			},
+		})
+	}
+
+	for _, nt := range newT {
+		tuple := nt.(map[string]interface{})
+
+		updates = append(updates, &waf.ByteMatchSetUpdate{
+			Action: aws.String(waf.ChangeActionInsert),
+			ByteMatchTuple: &waf.ByteMatchTuple{
+				FieldToMatch:         expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
+				PositionalConstraint: aws.String(tuple["positional_constraint"].(string)),
+				TargetString:         []byte(tuple["target_string"].(string)),
+				TextTransformation:   aws.String(tuple["text_transformation"].(string)),
+			},
+		})
+	}
+
+	return updates
+}
+
+func flattenWafIPSetDescriptors(in []*waf.IPSetDescriptor) []interface{} {
+	out := make([]interface{}, len(in), len(in))
+	for i, d := range in {
+		m := make(map[string]interface{})
+		m["type"] = *d.Type
+		m["value"] = *d.Value
+		out[i] = m
+	}
+	return out
+}
+
+func diffWafIPSetDescriptors(oldD, newD []interface{}) []*waf.IPSetUpdate {
+	updates := make([]*waf.IPSetUpdate, 0)
+
+	for _, od := range oldD {
+		descriptor := od.(map[string]interface{})
+
+		if idx, contains := sliceContainsMap(newD, descriptor); contains {
+			newD = append(newD[:idx], newD[idx+1:]...)
+			continue
+		}
+
+		updates = append(updates, &waf.IPSetUpdate{
+			Action: aws.String(waf.ChangeActionDelete),
+			IPSetDescriptor: &waf.IPSetDescriptor{
+				Type:  aws.String(descriptor["type"].(string)),
+				Value: aws.String(descriptor["value"].(string)),
+			},
+		})
+	}
+
+	for _, nd := range newD {
+		descriptor := nd.(map[string]interface{})
+
+		updates = append(updates, &waf.IPSetUpdate{
+			Action: aws.String(waf.ChangeActionInsert),
+			IPSetDescriptor: &waf.IPSetDescriptor{
+				Type:  aws.String(descriptor["type"].(string)),
+				Value: aws.String(descriptor["value"].(string)),
+			},
+		})
+	}
+
+	return updates
+}
+
+func flattenWafSqlInjectionMatchTuples(in []*waf.SqlInjectionMatchTuple) []interface{} {
+	out := make([]interface{}, len(in), len(in))
+	for i, t := range in {
+		m := make(map[string]interface{})
+		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
+		m["text_transformation"] = *t.TextTransformation
+		out[i] = m
+	}
+	return out
+}
+
+func diffWafSqlInjectionMatchTuples(oldT, newT []interface{}) []*waf.SqlInjectionMatchSetUpdate {
+	updates := make([]*waf.SqlInjectionMatchSetUpdate, 0)
+
+	for _, ot := range oldT {
+		tuple := ot.(map[string]interface{})
+
+		if idx, contains := sliceContainsMap(newT, tuple); contains {
+			newT = append(newT[:idx], newT[idx+1:]...)
+			continue
+		}
+
+		updates = append(updates, &waf.SqlInjectionMatchSetUpdate{
+			Action: aws.String(waf.ChangeActionDelete),
+			SqlInjectionMatchTuple: &waf.SqlInjectionMatchTuple{
+				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
+				TextTransformation: aws.String(tuple["text_transformation"].(string)),
+			},
+		})
+	}
+
+	for _, nt := range newT {
+		tuple := nt.(map[string]interface{})
+
+		updates = append(updates, &waf.SqlInjectionMatchSetUpdate{
+			Action: aws.String(waf.ChangeActionInsert),
+			SqlInjectionMatchTuple: &waf.SqlInjectionMatchTuple{
+				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
+				TextTransformation: aws.String(tuple["text_transformation"].(string)),
+			},
+		})
+	}
+
+	return updates
+}
+
+func flattenWafSizeConstraints(in []*waf.SizeConstraint) []interface{} {
+	out := make([]interface{}, len(in), len(in))
+	for i, t := range in {
+		m := make(map[string]interface{})
+		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
+		m["comparison_operator"] = *t.ComparisonOperator
+		m["size"] = *t.Size
+		m["text_transformation"] = *t.TextTransformation
+		out[i] = m
+	}
+	return out
+}
+
+func diffWafSizeConstraints(oldS, newS []interface{}) []*waf.SizeConstraintSetUpdate {
+	updates := make([]*waf.SizeConstraintSetUpdate, 0)
+
+	for _, os := range oldS {
+		constraint := os.(map[string]interface{})
+
+		if idx, contains := sliceContainsMap(newS, constraint); contains {
+			newS = append(newS[:idx], newS[idx+1:]...)
+			continue
+		}
+
+		updates = append(updates, &waf.SizeConstraintSetUpdate{
+			Action: aws.String(waf.ChangeActionDelete),
+			SizeConstraint: &waf.SizeConstraint{
+				FieldToMatch:       expandFieldToMatch(constraint["field_to_match"].(*schema.Set).List()),
+				ComparisonOperator: aws.String(constraint["comparison_operator"].(string)),
+				Size:               aws.Int64(int64(constraint["size"].(int))),
+				TextTransformation: aws.String(constraint["text_transformation"].(string)),
+			},
+		})
+	}
+
+	for _, ns := range newS {
+		constraint := ns.(map[string]interface{})
+
+		updates = append(updates, &waf.SizeConstraintSetUpdate{
+			Action: aws.String(waf.ChangeActionInsert),
+			SizeConstraint: &waf.SizeConstraint{
+				FieldToMatch:       expandFieldToMatch(constraint["field_to_match"].(*schema.Set).List()),
+				ComparisonOperator: aws.String(constraint["comparison_operator"].(string)),
+				Size:               aws.Int64(int64(constraint["size"].(int))),
+				TextTransformation: aws.String(constraint["text_transformation"].(string)),
+			},
+		})
+	}
+
+	return updates
+}
+
+func flattenWafXssMatchTuples(in []*waf.XssMatchTuple) []interface{} {
+	out := make([]interface{}, len(in), len(in))
+	for i, t := range in {
+		m := make(map[string]interface{})
+		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
+		m["text_transformation"] = *t.TextTransformation
+		out[i] = m
+	}
+	return out
+}
+
+func diffWafXssMatchTuples(oldT, newT []interface{}) []*waf.XssMatchSetUpdate {
+	updates := make([]*waf.XssMatchSetUpdate, 0)
+
+	for _, ot := range oldT {
+		tuple := ot.(map[string]interface{})
+
+		if idx, contains := sliceContainsMap(newT, tuple); contains {
+			newT = append(newT[:idx], newT[idx+1:]...)
+			continue
+		}
+
+		updates = append(updates, &waf.XssMatchSetUpdate{
+			Action: aws.String(waf.ChangeActionDelete),
+			XssMatchTuple: &waf.XssMatchTuple{
+				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
+				TextTransformation: aws.String(tuple["text_transformation"].(string)),
+			},
+		})
+	}
+
+	for _, nt := range newT {
+		tuple := nt.(map[string]interface{})
+
+		updates = append(updates, &waf.XssMatchSetUpdate{
+			Action: aws.String(waf.ChangeActionInsert),
+			XssMatchTuple: &waf.XssMatchTuple{
+				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()),
+				TextTransformation: aws.String(tuple["text_transformation"].(string)),
+			},
+		})
+	}
+
+	return updates
+}